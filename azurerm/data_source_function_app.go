@@ -0,0 +1,301 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmFunctionApp() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmFunctionAppRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"app_service_plan_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"app_settings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"connection_string": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"default_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"possible_outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"site_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"always_on": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"use_32_bit_worker_process": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"websockets_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"linux_fx_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http2_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"min_tls_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ftps_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pre_warmed_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"virtual_network_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cors": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_origins": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"support_credentials": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ip_restriction": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+
+			"site_credential": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Function App %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on AzureRM Function App %q: %+v", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Function App %q (Resource Group %q) ID", name, resGroup)
+	}
+	d.SetId(*resp.ID)
+
+	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App AppSettings %q: %+v", name, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStrings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App ConnectionStrings %q: %+v", name, err)
+	}
+
+	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing publishing credentials for Function App %q: %+v", name, err)
+	}
+	if err := siteCredFuture.WaitForCompletion(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for publishing credentials for Function App %q: %+v", name, err)
+	}
+	siteCred, err := siteCredFuture.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error reading publishing credentials for Function App %q: %+v", name, err)
+	}
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("app_service_plan_id", props.ServerFarmID)
+		d.Set("default_hostname", props.DefaultHostName)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
+		d.Set("possible_outbound_ip_addresses", props.PossibleOutboundIPAddresses)
+	}
+
+	appSettings := flattenAppServiceAppSettings(appSettingsResp.Properties)
+	delete(appSettings, "AzureWebJobsDashboard")
+	delete(appSettings, "AzureWebJobsStorage")
+	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
+	delete(appSettings, "WEBSITE_CONTENTSHARE")
+	delete(appSettings, "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING")
+
+	if err := d.Set("app_settings", appSettings); err != nil {
+		return err
+	}
+	if err := d.Set("connection_string", flattenFunctionAppConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	configResp, err := client.GetConfiguration(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App Configuration %q: %+v", name, err)
+	}
+
+	if err := d.Set("site_config", flattenFunctionAppSiteConfig(configResp.SiteConfig)); err != nil {
+		return err
+	}
+
+	if err := d.Set("identity", flattenFunctionAppIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("site_credential", flattenFunctionAppSiteCredential(siteCred.UserProperties)); err != nil {
+		return err
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func flattenFunctionAppSiteCredential(input *web.UserProperties) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{})
+
+	if input.PublishingUserName != nil {
+		result["username"] = *input.PublishingUserName
+	}
+
+	if input.PublishingPassword != nil {
+		result["password"] = *input.PublishingPassword
+	}
+
+	results = append(results, result)
+	return results
+}
@@ -0,0 +1,19 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"azurerm_function_app": dataSourceArmFunctionApp(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"azurerm_function_app":      resourceArmFunctionApp(),
+			"azurerm_function_app_slot": resourceArmFunctionAppSlot(),
+		},
+	}
+}
@@ -3,8 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -44,10 +45,6 @@ func resourceArmFunctionApp() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
-
-				// TODO: (tombuildsstuff) support Update once the API is fixed:
-				// https://github.com/Azure/azure-rest-api-specs/issues/1697
-				ForceNew: true,
 			},
 
 			"version": {
@@ -108,9 +105,7 @@ func resourceArmFunctionApp() *schema.Resource {
 				},
 			},
 
-			// TODO: (tombuildsstuff) support Update once the API is fixed:
-			// https://github.com/Azure/azure-rest-api-specs/issues/1697
-			"tags": tagsForceNewSchema(),
+			"tags": tagsSchema(),
 
 			"default_hostname": {
 				Type:     schema.TypeString,
@@ -126,20 +121,38 @@ func resourceArmFunctionApp() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
-
-				// TODO: (tombuildsstuff) support Update once the API is fixed:
-				// https://github.com/Azure/azure-rest-api-specs/issues/1697
-				ForceNew: true,
 			},
 
 			"https_only": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
+			},
 
-				// TODO: (tombuildsstuff) support Update once the API is fixed:
-				// https://github.com/Azure/azure-rest-api-specs/issues/1697
-				ForceNew: true,
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.ManagedServiceIdentityTypeSystemAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 
 			"site_config": {
@@ -164,6 +177,250 @@ func resourceArmFunctionApp() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"linux_fx_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"http2_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"min_tls_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(web.OneFullStopTwo),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.OneFullStopZero),
+								string(web.OneFullStopOne),
+								string(web.OneFullStopTwo),
+							}, false),
+						},
+						"ftps_state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(web.AllAllowed),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.AllAllowed),
+								string(web.FtpsOnly),
+								string(web.Disabled),
+							}, false),
+						},
+						"pre_warmed_instance_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 20),
+						},
+						"virtual_network_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cors": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_origins": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"support_credentials": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"ip_restriction": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"auth_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"additional_login_params": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+
+						"allowed_external_redirect_urls": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"default_provider": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.AzureActiveDirectory),
+								string(web.Facebook),
+								string(web.Google),
+								string(web.MicrosoftAccount),
+								string(web.Twitter),
+							}, false),
+						},
+
+						"token_store_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"unauthenticated_client_action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.AllowAnonymous),
+								string(web.RedirectToLoginPage),
+							}, false),
+						},
+
+						"active_directory": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"client_secret": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+									"allowed_audiences": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"facebook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"app_secret": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"oauth_scopes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"google": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"client_secret": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"oauth_scopes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"microsoft": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"client_secret": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"oauth_scopes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"twitter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"consumer_key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"consumer_secret": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -207,6 +464,7 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 		Kind:     &kind,
 		Location: &location,
 		Tags:     expandTags(tags),
+		Identity: expandFunctionAppIdentity(d),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -250,6 +508,38 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 	resGroup := id.ResourceGroup
 	name := id.Path["sites"]
 
+	if d.HasChange("tags") || d.HasChange("https_only") || d.HasChange("client_affinity_enabled") || d.HasChange("enabled") || d.HasChange("identity") {
+		location := azureRMNormalizeLocation(d.Get("location").(string))
+		kind := "functionapp"
+		appServicePlanID := d.Get("app_service_plan_id").(string)
+		enabled := d.Get("enabled").(bool)
+		clientAffinityEnabled := d.Get("client_affinity_enabled").(bool)
+		httpsOnly := d.Get("https_only").(bool)
+		tags := d.Get("tags").(map[string]interface{})
+
+		siteEnvelope := web.Site{
+			Kind:     &kind,
+			Location: &location,
+			Tags:     expandTags(tags),
+			Identity: expandFunctionAppIdentity(d),
+			SiteProperties: &web.SiteProperties{
+				ServerFarmID:          utils.String(appServicePlanID),
+				Enabled:               utils.Bool(enabled),
+				ClientAffinityEnabled: utils.Bool(clientAffinityEnabled),
+				HTTPSOnly:             utils.Bool(httpsOnly),
+			},
+		}
+
+		createFuture, err := client.CreateOrUpdate(ctx, resGroup, name, siteEnvelope)
+		if err != nil {
+			return fmt.Errorf("Error updating Function App %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := createFuture.WaitForCompletion(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Function App %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	if d.HasChange("app_settings") || d.HasChange("version") {
 		appSettings := expandFunctionAppAppSettings(d)
 		settings := web.StringDictionary{
@@ -286,6 +576,19 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("auth_settings") {
+		authSettingsRaw := d.Get("auth_settings").([]interface{})
+		authSettings := expandFunctionAppAuthSettings(authSettingsRaw)
+
+		auth := web.SiteAuthSettings{
+			SiteAuthSettingsProperties: &authSettings,
+		}
+
+		if _, err := client.UpdateAuthSettings(ctx, resGroup, name, auth); err != nil {
+			return fmt.Errorf("Error updating Auth Settings for Function App %q: %+v", name, err)
+		}
+	}
+
 	return resourceArmFunctionAppRead(d, meta)
 }
 
@@ -364,6 +667,19 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	identity := flattenFunctionAppIdentity(resp.Identity)
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	authSettingsResp, err := client.GetAuthSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App AuthSettings %q: %+v", name, err)
+	}
+	if err := d.Set("auth_settings", flattenFunctionAppAuthSettings(authSettingsResp.SiteAuthSettingsProperties)); err != nil {
+		return fmt.Errorf("Error setting `auth_settings`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -447,9 +763,94 @@ func expandFunctionAppSiteConfig(d *schema.ResourceData) web.SiteConfig {
 		siteConfig.WebSocketsEnabled = utils.Bool(v.(bool))
 	}
 
+	if v, ok := config["linux_fx_version"]; ok {
+		siteConfig.LinuxFxVersion = utils.String(v.(string))
+	}
+
+	if v, ok := config["http2_enabled"]; ok {
+		siteConfig.HTTP20Enabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["min_tls_version"]; ok {
+		siteConfig.MinTLSVersion = web.SupportedTLSVersions(v.(string))
+	}
+
+	if v, ok := config["ftps_state"]; ok {
+		siteConfig.FtpsState = web.FtpsState(v.(string))
+	}
+
+	if v, ok := config["pre_warmed_instance_count"]; ok {
+		siteConfig.PreWarmedInstanceCount = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := config["virtual_network_name"]; ok {
+		siteConfig.VnetName = utils.String(v.(string))
+	}
+
+	if v, ok := config["cors"]; ok {
+		siteConfig.Cors = expandFunctionAppSiteConfigCorsSettings(v.([]interface{}))
+	}
+
+	if v, ok := config["ip_restriction"]; ok {
+		restrictions := v.([]interface{})
+		siteConfig.IPSecurityRestrictions = expandFunctionAppSiteConfigIPRestriction(restrictions)
+	}
+
 	return siteConfig
 }
 
+func expandFunctionAppSiteConfigCorsSettings(input []interface{}) *web.CorsSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	setting := input[0].(map[string]interface{})
+	cors := &web.CorsSettings{}
+
+	if v, ok := setting["allowed_origins"]; ok {
+		input := v.(*schema.Set).List()
+		allowedOrigins := make([]string, 0)
+		for _, param := range input {
+			allowedOrigins = append(allowedOrigins, param.(string))
+		}
+		cors.AllowedOrigins = &allowedOrigins
+	}
+
+	if v, ok := setting["support_credentials"]; ok {
+		cors.SupportCredentials = utils.Bool(v.(bool))
+	}
+
+	return cors
+}
+
+func expandFunctionAppSiteConfigIPRestriction(input []interface{}) *[]web.IPSecurityRestriction {
+	restrictions := make([]web.IPSecurityRestriction, 0)
+
+	for _, r := range input {
+		if r == nil {
+			continue
+		}
+
+		restriction := r.(map[string]interface{})
+
+		ipAddress := restriction["ip_address"].(string)
+		vNetSubnetID := restriction["subnet_id"].(string)
+
+		ipSecurityRestriction := web.IPSecurityRestriction{}
+		if ipAddress != "" {
+			ipSecurityRestriction.IPAddress = utils.String(ipAddress)
+		}
+
+		if vNetSubnetID != "" {
+			ipSecurityRestriction.VnetSubnetResourceID = utils.String(vNetSubnetID)
+		}
+
+		restrictions = append(restrictions, ipSecurityRestriction)
+	}
+
+	return &restrictions
+}
+
 func flattenFunctionAppSiteConfig(input *web.SiteConfig) []interface{} {
 	results := make([]interface{}, 0)
 	result := make(map[string]interface{}, 0)
@@ -471,10 +872,77 @@ func flattenFunctionAppSiteConfig(input *web.SiteConfig) []interface{} {
 		result["websockets_enabled"] = *input.WebSocketsEnabled
 	}
 
+	if input.LinuxFxVersion != nil {
+		result["linux_fx_version"] = *input.LinuxFxVersion
+	}
+
+	if input.HTTP20Enabled != nil {
+		result["http2_enabled"] = *input.HTTP20Enabled
+	}
+
+	result["min_tls_version"] = string(input.MinTLSVersion)
+	result["ftps_state"] = string(input.FtpsState)
+
+	if input.PreWarmedInstanceCount != nil {
+		result["pre_warmed_instance_count"] = int(*input.PreWarmedInstanceCount)
+	}
+
+	if input.VnetName != nil {
+		result["virtual_network_name"] = *input.VnetName
+	}
+
+	result["cors"] = flattenFunctionAppSiteConfigCorsSettings(input.Cors)
+	result["ip_restriction"] = flattenFunctionAppSiteConfigIPRestriction(input.IPSecurityRestrictions)
+
 	results = append(results, result)
 	return results
 }
 
+func flattenFunctionAppSiteConfigCorsSettings(input *web.CorsSettings) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{})
+
+	if input.SupportCredentials != nil {
+		result["support_credentials"] = *input.SupportCredentials
+	}
+
+	if input.AllowedOrigins != nil {
+		result["allowed_origins"] = *input.AllowedOrigins
+	}
+
+	results = append(results, result)
+	return results
+}
+
+func flattenFunctionAppSiteConfigIPRestriction(input *[]web.IPSecurityRestriction) []interface{} {
+	restrictions := make([]interface{}, 0)
+
+	if input == nil {
+		return restrictions
+	}
+
+	for _, v := range *input {
+		restriction := make(map[string]interface{})
+
+		if v.IPAddress != nil {
+			restriction["ip_address"] = *v.IPAddress
+		}
+
+		if v.VnetSubnetResourceID != nil {
+			restriction["subnet_id"] = *v.VnetSubnetResourceID
+		}
+
+		restrictions = append(restrictions, restriction)
+	}
+
+	return restrictions
+}
+
 func expandFunctionAppConnectionStrings(d *schema.ResourceData) map[string]*web.ConnStringValueTypePair {
 	input := d.Get("connection_string").([]interface{})
 	output := make(map[string]*web.ConnStringValueTypePair, len(input))
@@ -508,3 +976,349 @@ func flattenFunctionAppConnectionStrings(input map[string]*web.ConnStringValueTy
 
 	return results
 }
+
+func expandFunctionAppIdentity(d *schema.ResourceData) *web.ManagedServiceIdentity {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 {
+		return nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	identityType := web.ManagedServiceIdentityType(identity["type"].(string))
+
+	return &web.ManagedServiceIdentity{
+		Type: identityType,
+	}
+}
+
+func flattenFunctionAppIdentity(identity *web.ManagedServiceIdentity) []interface{} {
+	if identity == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = string(identity.Type)
+
+	if identity.PrincipalID != nil {
+		result["principal_id"] = *identity.PrincipalID
+	}
+
+	if identity.TenantID != nil {
+		result["tenant_id"] = *identity.TenantID
+	}
+
+	return []interface{}{result}
+}
+
+func expandFunctionAppAuthSettings(input []interface{}) web.SiteAuthSettingsProperties {
+	siteAuthSettingsProperties := web.SiteAuthSettingsProperties{}
+
+	if len(input) == 0 {
+		return siteAuthSettingsProperties
+	}
+
+	setting := input[0].(map[string]interface{})
+
+	if v, ok := setting["enabled"]; ok {
+		siteAuthSettingsProperties.Enabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := setting["additional_login_params"]; ok {
+		params := v.(map[string]interface{})
+
+		if len(params) > 0 {
+			input := expandFunctionAppAuthSettingsAdditionalLoginParams(params)
+			siteAuthSettingsProperties.AdditionalLoginParams = &input
+		}
+	}
+
+	if v, ok := setting["allowed_external_redirect_urls"]; ok {
+		input := v.([]interface{})
+
+		urls := make([]string, 0)
+		for _, param := range input {
+			urls = append(urls, param.(string))
+		}
+
+		siteAuthSettingsProperties.AllowedExternalRedirectUrls = &urls
+	}
+
+	if v, ok := setting["default_provider"]; ok {
+		siteAuthSettingsProperties.DefaultProvider = web.BuiltInAuthenticationProvider(v.(string))
+	}
+
+	if v, ok := setting["token_store_enabled"]; ok {
+		siteAuthSettingsProperties.TokenStoreEnabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := setting["unauthenticated_client_action"]; ok {
+		siteAuthSettingsProperties.UnauthenticatedClientAction = web.UnauthenticatedClientAction(v.(string))
+	}
+
+	if v, ok := setting["active_directory"]; ok {
+		activeDirectorySettings := v.([]interface{})
+
+		for _, setting := range activeDirectorySettings {
+			settingMap := setting.(map[string]interface{})
+
+			if v, ok := settingMap["client_id"]; ok {
+				siteAuthSettingsProperties.ClientID = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["client_secret"]; ok {
+				siteAuthSettingsProperties.ClientSecret = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["allowed_audiences"]; ok {
+				input := v.([]interface{})
+
+				audiences := make([]string, 0)
+				for _, param := range input {
+					audiences = append(audiences, param.(string))
+				}
+
+				siteAuthSettingsProperties.AllowedAudiences = &audiences
+			}
+		}
+	}
+
+	if v, ok := setting["facebook"]; ok {
+		facebookSettings := v.([]interface{})
+
+		for _, setting := range facebookSettings {
+			settingMap := setting.(map[string]interface{})
+
+			if v, ok := settingMap["app_id"]; ok {
+				siteAuthSettingsProperties.FacebookAppID = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["app_secret"]; ok {
+				siteAuthSettingsProperties.FacebookAppSecret = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["oauth_scopes"]; ok {
+				input := v.([]interface{})
+
+				scopes := make([]string, 0)
+				for _, param := range input {
+					scopes = append(scopes, param.(string))
+				}
+
+				siteAuthSettingsProperties.FacebookOAuthScopes = &scopes
+			}
+		}
+	}
+
+	if v, ok := setting["google"]; ok {
+		googleSettings := v.([]interface{})
+
+		for _, setting := range googleSettings {
+			settingMap := setting.(map[string]interface{})
+
+			if v, ok := settingMap["client_id"]; ok {
+				siteAuthSettingsProperties.GoogleClientID = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["client_secret"]; ok {
+				siteAuthSettingsProperties.GoogleClientSecret = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["oauth_scopes"]; ok {
+				input := v.([]interface{})
+
+				scopes := make([]string, 0)
+				for _, param := range input {
+					scopes = append(scopes, param.(string))
+				}
+
+				siteAuthSettingsProperties.GoogleOAuthScopes = &scopes
+			}
+		}
+	}
+
+	if v, ok := setting["microsoft"]; ok {
+		microsoftSettings := v.([]interface{})
+
+		for _, setting := range microsoftSettings {
+			settingMap := setting.(map[string]interface{})
+
+			if v, ok := settingMap["client_id"]; ok {
+				siteAuthSettingsProperties.MicrosoftAccountClientID = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["client_secret"]; ok {
+				siteAuthSettingsProperties.MicrosoftAccountClientSecret = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["oauth_scopes"]; ok {
+				input := v.([]interface{})
+
+				scopes := make([]string, 0)
+				for _, param := range input {
+					scopes = append(scopes, param.(string))
+				}
+
+				siteAuthSettingsProperties.MicrosoftAccountOAuthScopes = &scopes
+			}
+		}
+	}
+
+	if v, ok := setting["twitter"]; ok {
+		twitterSettings := v.([]interface{})
+
+		for _, setting := range twitterSettings {
+			settingMap := setting.(map[string]interface{})
+
+			if v, ok := settingMap["consumer_key"]; ok {
+				siteAuthSettingsProperties.TwitterConsumerKey = utils.String(v.(string))
+			}
+
+			if v, ok := settingMap["consumer_secret"]; ok {
+				siteAuthSettingsProperties.TwitterConsumerSecret = utils.String(v.(string))
+			}
+		}
+	}
+
+	return siteAuthSettingsProperties
+}
+
+func expandFunctionAppAuthSettingsAdditionalLoginParams(input map[string]interface{}) []string {
+	params := make([]string, 0)
+
+	for k, v := range input {
+		params = append(params, fmt.Sprintf("%s=%s", k, v.(string)))
+	}
+
+	return params
+}
+
+func flattenFunctionAppAuthSettings(input *web.SiteAuthSettingsProperties) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{})
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+
+	result["default_provider"] = string(input.DefaultProvider)
+	result["unauthenticated_client_action"] = string(input.UnauthenticatedClientAction)
+
+	if input.TokenStoreEnabled != nil {
+		result["token_store_enabled"] = *input.TokenStoreEnabled
+	}
+
+	if input.AllowedExternalRedirectUrls != nil {
+		result["allowed_external_redirect_urls"] = *input.AllowedExternalRedirectUrls
+	}
+
+	if input.AdditionalLoginParams != nil {
+		result["additional_login_params"] = flattenFunctionAppAuthSettingsAdditionalLoginParams(*input.AdditionalLoginParams)
+	}
+
+	activeDirectorySettings := make([]interface{}, 0)
+	if input.ClientID != nil {
+		activeDirectorySetting := map[string]interface{}{
+			"client_id": *input.ClientID,
+		}
+
+		if input.ClientSecret != nil {
+			activeDirectorySetting["client_secret"] = *input.ClientSecret
+		}
+
+		if input.AllowedAudiences != nil {
+			activeDirectorySetting["allowed_audiences"] = *input.AllowedAudiences
+		}
+
+		activeDirectorySettings = append(activeDirectorySettings, activeDirectorySetting)
+	}
+	result["active_directory"] = activeDirectorySettings
+
+	facebookSettings := make([]interface{}, 0)
+	if input.FacebookAppID != nil {
+		facebookSetting := map[string]interface{}{
+			"app_id": *input.FacebookAppID,
+		}
+
+		if input.FacebookAppSecret != nil {
+			facebookSetting["app_secret"] = *input.FacebookAppSecret
+		}
+
+		if input.FacebookOAuthScopes != nil {
+			facebookSetting["oauth_scopes"] = *input.FacebookOAuthScopes
+		}
+
+		facebookSettings = append(facebookSettings, facebookSetting)
+	}
+	result["facebook"] = facebookSettings
+
+	googleSettings := make([]interface{}, 0)
+	if input.GoogleClientID != nil {
+		googleSetting := map[string]interface{}{
+			"client_id": *input.GoogleClientID,
+		}
+
+		if input.GoogleClientSecret != nil {
+			googleSetting["client_secret"] = *input.GoogleClientSecret
+		}
+
+		if input.GoogleOAuthScopes != nil {
+			googleSetting["oauth_scopes"] = *input.GoogleOAuthScopes
+		}
+
+		googleSettings = append(googleSettings, googleSetting)
+	}
+	result["google"] = googleSettings
+
+	microsoftSettings := make([]interface{}, 0)
+	if input.MicrosoftAccountClientID != nil {
+		microsoftSetting := map[string]interface{}{
+			"client_id": *input.MicrosoftAccountClientID,
+		}
+
+		if input.MicrosoftAccountClientSecret != nil {
+			microsoftSetting["client_secret"] = *input.MicrosoftAccountClientSecret
+		}
+
+		if input.MicrosoftAccountOAuthScopes != nil {
+			microsoftSetting["oauth_scopes"] = *input.MicrosoftAccountOAuthScopes
+		}
+
+		microsoftSettings = append(microsoftSettings, microsoftSetting)
+	}
+	result["microsoft"] = microsoftSettings
+
+	twitterSettings := make([]interface{}, 0)
+	if input.TwitterConsumerKey != nil {
+		twitterSetting := map[string]interface{}{
+			"consumer_key": *input.TwitterConsumerKey,
+		}
+
+		if input.TwitterConsumerSecret != nil {
+			twitterSetting["consumer_secret"] = *input.TwitterConsumerSecret
+		}
+
+		twitterSettings = append(twitterSettings, twitterSetting)
+	}
+	result["twitter"] = twitterSettings
+
+	results = append(results, result)
+	return results
+}
+
+func flattenFunctionAppAuthSettingsAdditionalLoginParams(input []string) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	for _, param := range input {
+		parts := strings.Split(param, "=")
+		if len(parts) == 2 {
+			result[parts[0]] = parts[1]
+		}
+	}
+
+	return result
+}
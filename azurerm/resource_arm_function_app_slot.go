@@ -0,0 +1,517 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Deployment slots let a Function App be staged and swapped without affecting the production slot.
+// This resource shares its `site_config`/`app_settings`/`connection_string`/`identity` shape with `azurerm_function_app`.
+func resourceArmFunctionAppSlot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFunctionAppSlotCreate,
+		Read:   resourceArmFunctionAppSlotRead,
+		Update: resourceArmFunctionAppSlotUpdate,
+		Delete: resourceArmFunctionAppSlotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServiceName,
+			},
+
+			"function_app_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServiceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"app_service_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "~1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"~1",
+					"beta",
+				}, false),
+			},
+
+			"storage_connection_string": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"app_settings": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"connection_string": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.APIHub),
+								string(web.Custom),
+								string(web.DocDb),
+								string(web.EventHub),
+								string(web.MySQL),
+								string(web.NotificationHub),
+								string(web.PostgreSQL),
+								string(web.RedisCache),
+								string(web.ServiceBus),
+								string(web.SQLAzure),
+								string(web.SQLServer),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"default_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"client_affinity_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.ManagedServiceIdentityTypeSystemAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"site_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"always_on": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_32_bit_worker_process": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"websockets_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"linux_fx_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"http2_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"min_tls_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(web.OneFullStopTwo),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.OneFullStopZero),
+								string(web.OneFullStopOne),
+								string(web.OneFullStopTwo),
+							}, false),
+						},
+						"ftps_state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(web.AllAllowed),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(web.AllAllowed),
+								string(web.FtpsOnly),
+								string(web.Disabled),
+							}, false),
+						},
+						"pre_warmed_instance_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 20),
+						},
+						"virtual_network_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cors": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_origins": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"support_credentials": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"ip_restriction": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmFunctionAppSlotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Function App Slot creation.")
+
+	slot := d.Get("name").(string)
+	functionAppName := d.Get("function_app_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	kind := "functionapp"
+	appServicePlanID := d.Get("app_service_plan_id").(string)
+	enabled := d.Get("enabled").(bool)
+	clientAffinityEnabled := d.Get("client_affinity_enabled").(bool)
+	httpsOnly := d.Get("https_only").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+	basicAppSettings := getBasicFunctionAppAppSettings(d)
+	siteConfig := expandFunctionAppSiteConfig(d)
+	siteConfig.AppSettings = &basicAppSettings
+
+	siteEnvelope := web.Site{
+		Kind:     &kind,
+		Location: &location,
+		Tags:     expandTags(tags),
+		Identity: expandFunctionAppIdentity(d),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID:          utils.String(appServicePlanID),
+			Enabled:               utils.Bool(enabled),
+			ClientAffinityEnabled: utils.Bool(clientAffinityEnabled),
+			HTTPSOnly:             utils.Bool(httpsOnly),
+			SiteConfig:            &siteConfig,
+		},
+	}
+
+	createFuture, err := client.CreateOrUpdateSlot(ctx, resGroup, functionAppName, siteEnvelope, slot)
+	if err != nil {
+		return err
+	}
+
+	err = createFuture.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return err
+	}
+
+	read, err := client.GetSlot(ctx, resGroup, functionAppName, slot)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Function App Slot %q (Function App %q / Resource Group %q) ID", slot, functionAppName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmFunctionAppSlotUpdate(d, meta)
+}
+
+func resourceArmFunctionAppSlotUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	functionAppName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	if d.HasChange("tags") || d.HasChange("https_only") || d.HasChange("client_affinity_enabled") || d.HasChange("enabled") || d.HasChange("identity") {
+		location := azureRMNormalizeLocation(d.Get("location").(string))
+		kind := "functionapp"
+		appServicePlanID := d.Get("app_service_plan_id").(string)
+		enabled := d.Get("enabled").(bool)
+		clientAffinityEnabled := d.Get("client_affinity_enabled").(bool)
+		httpsOnly := d.Get("https_only").(bool)
+		tags := d.Get("tags").(map[string]interface{})
+
+		siteEnvelope := web.Site{
+			Kind:     &kind,
+			Location: &location,
+			Tags:     expandTags(tags),
+			Identity: expandFunctionAppIdentity(d),
+			SiteProperties: &web.SiteProperties{
+				ServerFarmID:          utils.String(appServicePlanID),
+				Enabled:               utils.Bool(enabled),
+				ClientAffinityEnabled: utils.Bool(clientAffinityEnabled),
+				HTTPSOnly:             utils.Bool(httpsOnly),
+			},
+		}
+
+		createFuture, err := client.CreateOrUpdateSlot(ctx, resGroup, functionAppName, siteEnvelope, slot)
+		if err != nil {
+			return fmt.Errorf("Error updating Function App Slot %q (Function App %q / Resource Group %q): %+v", slot, functionAppName, resGroup, err)
+		}
+
+		if err := createFuture.WaitForCompletion(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Function App Slot %q (Function App %q / Resource Group %q): %+v", slot, functionAppName, resGroup, err)
+		}
+	}
+
+	if d.HasChange("app_settings") || d.HasChange("version") {
+		appSettings := expandFunctionAppAppSettings(d)
+		settings := web.StringDictionary{
+			Properties: appSettings,
+		}
+
+		if _, err := client.UpdateApplicationSettingsSlot(ctx, resGroup, functionAppName, settings, slot); err != nil {
+			return fmt.Errorf("Error updating Application Settings for Function App Slot %q (Function App %q): %+v", slot, functionAppName, err)
+		}
+	}
+
+	if d.HasChange("site_config") {
+		siteConfig := expandFunctionAppSiteConfig(d)
+		siteConfigResource := web.SiteConfigResource{
+			SiteConfig: &siteConfig,
+		}
+		if _, err := client.CreateOrUpdateConfigurationSlot(ctx, resGroup, functionAppName, siteConfigResource, slot); err != nil {
+			return fmt.Errorf("Error updating Configuration for Function App Slot %q (Function App %q): %+v", slot, functionAppName, err)
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		connectionStrings := expandFunctionAppConnectionStrings(d)
+		properties := web.ConnectionStringDictionary{
+			Properties: connectionStrings,
+		}
+
+		if _, err := client.UpdateConnectionStringsSlot(ctx, resGroup, functionAppName, properties, slot); err != nil {
+			return fmt.Errorf("Error updating Connection Strings for Function App Slot %q (Function App %q): %+v", slot, functionAppName, err)
+		}
+	}
+
+	return resourceArmFunctionAppSlotRead(d, meta)
+}
+
+func resourceArmFunctionAppSlotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	functionAppName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	resp, err := client.GetSlot(ctx, resGroup, functionAppName, slot)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Function App Slot %q (Function App %q / Resource Group %q) was not found - removing from state", slot, functionAppName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on AzureRM Function App Slot %q (Function App %q): %+v", slot, functionAppName, err)
+	}
+
+	appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, resGroup, functionAppName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App Slot %q AppSettings: %+v", slot, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStringsSlot(ctx, resGroup, functionAppName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App Slot %q ConnectionStrings: %+v", slot, err)
+	}
+
+	d.Set("name", slot)
+	d.Set("function_app_name", functionAppName)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("app_service_plan_id", props.ServerFarmID)
+		d.Set("enabled", props.Enabled)
+		d.Set("default_hostname", props.DefaultHostName)
+		d.Set("https_only", props.HTTPSOnly)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+	}
+
+	appSettings := flattenAppServiceAppSettings(appSettingsResp.Properties)
+
+	d.Set("storage_connection_string", appSettings["AzureWebJobsStorage"])
+	d.Set("version", appSettings["FUNCTIONS_EXTENSION_VERSION"])
+
+	delete(appSettings, "AzureWebJobsDashboard")
+	delete(appSettings, "AzureWebJobsStorage")
+	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
+	delete(appSettings, "WEBSITE_CONTENTSHARE")
+	delete(appSettings, "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING")
+
+	if err := d.Set("app_settings", appSettings); err != nil {
+		return err
+	}
+	if err := d.Set("connection_string", flattenFunctionAppConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	configResp, err := client.GetConfigurationSlot(ctx, resGroup, functionAppName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App Slot %q Configuration: %+v", slot, err)
+	}
+
+	siteConfig := flattenFunctionAppSiteConfig(configResp.SiteConfig)
+	if err := d.Set("site_config", siteConfig); err != nil {
+		return err
+	}
+
+	identity := flattenFunctionAppIdentity(resp.Identity)
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmFunctionAppSlotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	functionAppName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	log.Printf("[DEBUG] Deleting Function App Slot %q (Function App %q / Resource Group %q)", slot, functionAppName, resGroup)
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	resp, err := client.DeleteSlot(ctx, resGroup, functionAppName, slot, &deleteMetrics, &deleteEmptyServerFarm)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return err
+		}
+	}
+
+	return nil
+}
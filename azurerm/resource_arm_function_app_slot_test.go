@@ -0,0 +1,133 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMFunctionAppSlot_basic(t *testing.T) {
+	resourceName := "azurerm_function_app_slot.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMFunctionAppSlot_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFunctionAppSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppSlotExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFunctionAppSlotExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Function App Slot not found: %s", resourceName)
+		}
+
+		slot := rs.Primary.Attributes["name"]
+		functionAppName := rs.Primary.Attributes["function_app_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Function App Slot: %s", slot)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetSlot(ctx, resourceGroup, functionAppName, slot)
+		if err != nil {
+			return fmt.Errorf("Bad: GetSlot on appServicesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Function App Slot %q (Function App %q / resource group: %q) does not exist", slot, functionAppName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFunctionAppSlotDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_function_app_slot" {
+			continue
+		}
+
+		slot := rs.Primary.Attributes["name"]
+		functionAppName := rs.Primary.Attributes["function_app_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetSlot(ctx, resourceGroup, functionAppName, slot)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Function App Slot still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFunctionAppSlot_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_function_app" "test" {
+  name                      = "acctest-%d-func"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  app_service_plan_id       = "${azurerm_app_service_plan.test.id}"
+  storage_connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+}
+
+resource "azurerm_function_app_slot" "test" {
+  name                      = "acctest-%d-func-staging"
+  function_app_name         = "${azurerm_function_app.test.name}"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  app_service_plan_id       = "${azurerm_app_service_plan.test.id}"
+  storage_connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}